@@ -0,0 +1,92 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// PodAutoscalerConditionReady is set when the revision is starting to
+	// materialize runtime resources, and becomes true when the PA's
+	// ScaleTargetRef is receiving traffic as expected.
+	PodAutoscalerConditionReady = apis.ConditionReady
+
+	// PodAutoscalerConditionActive is set when the PA's ScaleTargetRef is
+	// receiving traffic.
+	PodAutoscalerConditionActive apis.ConditionType = "Active"
+
+	// PodAutoscalerConditionScaleTargetInitialized is set when the PA's
+	// ScaleTargetRef has successfully been initialized at least once.
+	PodAutoscalerConditionScaleTargetInitialized apis.ConditionType = "ScaleTargetInitialized"
+)
+
+// paCondSet is the condition set used by all PodAutoscalers.
+var paCondSet = apis.NewLivingConditionSet(
+	PodAutoscalerConditionActive,
+	PodAutoscalerConditionScaleTargetInitialized,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements
+// the KRShaped interface.
+func (pa *PodAutoscaler) GetConditionSet() apis.ConditionSet {
+	return paCondSet
+}
+
+// GetGroupVersionKind returns the GroupVersionKind for the PodAutoscaler.
+func (pa *PodAutoscaler) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("PodAutoscaler")
+}
+
+// IsReady looks at the conditions and, if there is a Ready condition,
+// returns its status.
+func (pas *PodAutoscalerStatus) IsReady() bool {
+	return paCondSet.Manage(pas).IsHappy()
+}
+
+// IsActivating returns true if the PA has been marked Active, but its
+// ScaleTargetInitialized condition has not yet been set.
+func (pas *PodAutoscalerStatus) IsActivating() bool {
+	cond := pas.GetCondition(PodAutoscalerConditionActive)
+	return cond != nil && cond.Status == corev1.ConditionTrue &&
+		pas.GetCondition(PodAutoscalerConditionScaleTargetInitialized) == nil
+}
+
+// InitializeConditions sets the initial values to the conditions.
+func (pas *PodAutoscalerStatus) InitializeConditions() {
+	paCondSet.Manage(pas).InitializeConditions()
+}
+
+// MarkActive marks the PA's Active condition to True.
+func (pas *PodAutoscalerStatus) MarkActive() {
+	paCondSet.Manage(pas).MarkTrue(PodAutoscalerConditionActive)
+}
+
+// MarkInactive marks the PA's Active condition to False with the given
+// reason and message.
+func (pas *PodAutoscalerStatus) MarkInactive(reason, message string) {
+	paCondSet.Manage(pas).MarkFalse(PodAutoscalerConditionActive, reason, message)
+}
+
+// IsReachable returns whether the PA's target is reachable, i.e. known
+// to be referenced by a Route. An Unknown reachability is treated as
+// reachable so that scale-to-zero is not applied prematurely.
+func (pa *PodAutoscaler) IsReachable() bool {
+	return pa.Spec.Reachability != ReachabilityUnreachable
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodAutoscaler is a Knative abstraction that encapsulates the interface by which Knative
+// components instantiate autoscalers. This definition is an abstraction that may be backed
+// by multiple definitions. For more information, see the Knative Pluggability presentation
+// that was given to the Serving WG.
+type PodAutoscaler struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec PodAutoscalerSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status PodAutoscalerStatus `json:"status,omitempty"`
+}
+
+// Verify that PodAutoscaler adheres to the appropriate interfaces.
+var (
+	// Check that PodAutoscaler can be validated and defaulted.
+	_ apis.Validatable = (*PodAutoscaler)(nil)
+	_ apis.Defaultable = (*PodAutoscaler)(nil)
+
+	// Check that we can create OwnerReferences to a PodAutoscaler.
+	_ kmeta.OwnerRefable = (*PodAutoscaler)(nil)
+
+	// Check that the type conforms to the duck Knative Resource shape.
+	_ duckv1.KRShaped = (*PodAutoscaler)(nil)
+)
+
+// ReachabilityType is the enumeration type for the Reachability field.
+type ReachabilityType string
+
+const (
+	// ReachabilityReachable means the PodAutoscaler's target is reachable via a Route.
+	ReachabilityReachable ReachabilityType = "Reachable"
+
+	// ReachabilityUnreachable means the PodAutoscaler's target has no reachable
+	// ingress and may be scaled to zero more aggressively.
+	ReachabilityUnreachable ReachabilityType = "Unreachable"
+
+	// ReachabilityUnknown means the reachability of the PodAutoscaler's target
+	// could not be determined.
+	ReachabilityUnknown ReachabilityType = "Unknown"
+)
+
+// ProtocolType is the enumeration type for the Protocol field.
+type ProtocolType string
+
+const (
+	// ProtocolHTTP1 is the default protocol, using port name "http1".
+	ProtocolHTTP1 ProtocolType = "http1"
+
+	// ProtocolH2C is the HTTP/2 cleartext protocol, using port name "h2c".
+	ProtocolH2C ProtocolType = "h2c"
+)
+
+// PodAutoscalerSpec holds the desired state of the PodAutoscaler (from the client).
+type PodAutoscalerSpec struct {
+	// ScaleTargetRef defines the /scale-able resource that this PodAutoscaler
+	// is responsible for quickly right-sizing.
+	ScaleTargetRef corev1.ObjectReference `json:"scaleTargetRef"`
+
+	// ContainerConcurrency specifies the maximum allowed in-flight (concurrent)
+	// requests per container of the Revision. Defaults to `0` which means
+	// concurrency to the application is not limited.
+	// +optional
+	ContainerConcurrency int64 `json:"containerConcurrency,omitempty"`
+
+	// Reachability specifies whether the target of this PodAutoscaler is
+	// reachable via a Route, which determines whether it may be scaled to
+	// zero more aggressively.
+	// +optional
+	Reachability ReachabilityType `json:"reachability,omitempty"`
+
+	// ProtocolType is the app-level protocol to use for probes and autoscaler
+	// metric collection, inferred from the container's port name.
+	// +optional
+	ProtocolType ProtocolType `json:"protocolType,omitempty"`
+}
+
+// PodAutoscalerStatus communicates the observed state of the PodAutoscaler (from the controller).
+type PodAutoscalerStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// ServiceName is the K8s Service name that serves the revision, scaled by
+	// this PA. The service is created and owned by the ServerlessService
+	// object owned by this PA.
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// MetricsServiceName is the K8s Service name that provides revision metrics.
+	// +optional
+	MetricsServiceName string `json:"metricsServiceName,omitempty"`
+
+	// DesiredScale shows the current desired number of replicas for the revision.
+	// +optional
+	DesiredScale *int32 `json:"desiredScale,omitempty"`
+
+	// ActualScale shows the actual number of replicas for the revision.
+	// +optional
+	ActualScale *int32 `json:"actualScale,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodAutoscalerList is a list of PodAutoscaler resources.
+type PodAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []PodAutoscaler `json:"items"`
+}
+
+// GetStatus retrieves the status of the PodAutoscaler. Implements the KRShaped interface.
+func (pa *PodAutoscaler) GetStatus() *duckv1.Status {
+	return &pa.Status.Status
+}
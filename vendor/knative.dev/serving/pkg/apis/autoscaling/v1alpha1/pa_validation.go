@@ -0,0 +1,57 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable.
+func (pa *PodAutoscaler) Validate(ctx context.Context) *apis.FieldError {
+	return pa.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable.
+func (pas *PodAutoscalerSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if equality := pas.ScaleTargetRef; equality.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("scaleTargetRef.name"))
+	}
+
+	if pas.ContainerConcurrency < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(pas.ContainerConcurrency, "containerConcurrency"))
+	}
+
+	switch pas.Reachability {
+	case "", ReachabilityReachable, ReachabilityUnreachable, ReachabilityUnknown:
+		// Valid.
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(pas.Reachability, "reachability"))
+	}
+
+	switch pas.ProtocolType {
+	case "", ProtocolHTTP1, ProtocolH2C:
+		// Valid.
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(pas.ProtocolType, "protocolType"))
+	}
+
+	return errs
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import "context"
+
+// defaultsKey is the context key under which the webhook's observed
+// config-defaults ConfigMap is stored, so that SetDefaults can read it
+// without threading a client through every call site.
+type defaultsKey struct{}
+
+// ToContext returns a context carrying d.
+func ToContext(ctx context.Context, d *Defaults) context.Context {
+	return context.WithValue(ctx, defaultsKey{}, d)
+}
+
+// FromContextOrDefaults returns the Defaults stored in ctx, or a
+// zero-value Defaults (no ceiling enforced) if none was stored.
+func FromContextOrDefaults(ctx context.Context) *Defaults {
+	if d, ok := ctx.Value(defaultsKey{}).(*Defaults); ok {
+		return d
+	}
+	return &Defaults{MaxDurationSecondsCeiling: defaultMaxDurationSecondsCeiling}
+}
+
+// imagePolicyKey is the context key under which the webhook's observed
+// config-imagepolicy ConfigMap is stored, mirroring defaultsKey above.
+type imagePolicyKey struct{}
+
+// ImagePolicyToContext returns a context carrying c.
+func ImagePolicyToContext(ctx context.Context, c *ImagePolicy) context.Context {
+	return context.WithValue(ctx, imagePolicyKey{}, c)
+}
+
+// ImagePolicyFromContextOrDefaults returns the ImagePolicy stored in ctx,
+// or a zero-value ImagePolicy (DefaultPolicy "Off", no allow-listed
+// registries) if none was stored.
+func ImagePolicyFromContextOrDefaults(ctx context.Context) *ImagePolicy {
+	if c, ok := ctx.Value(imagePolicyKey{}).(*ImagePolicy); ok {
+		return c
+	}
+	return &ImagePolicy{DefaultPolicy: "Off"}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DefaultsConfigName is the name of the ConfigMap holding cluster-wide
+	// defaults applied to Revisions at admission time.
+	DefaultsConfigName = "config-defaults"
+
+	// defaultMaxDurationSecondsCeiling is used when the ConfigMap omits
+	// max-duration-seconds-ceiling, matching today's effectively unbounded
+	// MaxDurationSeconds behavior.
+	defaultMaxDurationSecondsCeiling = 0
+)
+
+// Defaults contains the cluster-wide defaulting configuration applied to
+// Revisions.
+type Defaults struct {
+	// MaxDurationSecondsCeiling caps the value a Revision may set for
+	// Spec.MaxDurationSeconds. Zero means no ceiling is enforced.
+	MaxDurationSecondsCeiling int64
+}
+
+// NewDefaultsConfigFromMap creates a Defaults from the supplied ConfigMap data.
+func NewDefaultsConfigFromMap(data map[string]string) (*Defaults, error) {
+	nc := &Defaults{
+		MaxDurationSecondsCeiling: defaultMaxDurationSecondsCeiling,
+	}
+
+	if v, ok := data["max-duration-seconds-ceiling"]; ok {
+		ceiling, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		nc.MaxDurationSecondsCeiling = ceiling
+	}
+
+	return nc, nil
+}
+
+// NewDefaultsConfigFromConfigMap creates a Defaults from the supplied ConfigMap.
+func NewDefaultsConfigFromConfigMap(config *corev1.ConfigMap) (*Defaults, error) {
+	return NewDefaultsConfigFromMap(config.Data)
+}
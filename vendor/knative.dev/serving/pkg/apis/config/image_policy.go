@@ -0,0 +1,97 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ImagePolicyConfigName is the name of the ConfigMap holding cluster-wide
+	// ImageReview settings.
+	ImagePolicyConfigName = "config-imagepolicy"
+)
+
+// ImagePolicy contains the cluster-wide configuration for the ImageReview
+// admission integration. A Revision's own Spec.ImagePolicy selects the
+// enforcement mode; this config supplies the backend to call and the
+// registries that bypass it.
+type ImagePolicy struct {
+	// WebhookURL is the endpoint of the imagepolicy.k8s.io-style backend
+	// that ImageReview requests are POSTed to.
+	WebhookURL string
+
+	// CABundle is the PEM-encoded CA bundle used to verify WebhookURL's
+	// TLS certificate.
+	CABundle []byte
+
+	// DefaultPolicy is applied to Revisions that do not set
+	// Spec.ImagePolicy. Holds one of the v1.ImagePolicyType values
+	// ("Enforce", "Warn", "Off"); kept as a plain string here so this
+	// package doesn't import knative.dev/serving/pkg/apis/serving/v1,
+	// which itself depends on config.Defaults for MaxDurationSeconds
+	// capping.
+	DefaultPolicy string
+
+	// AllowedRegistries lists image registry hostnames that are admitted
+	// without consulting the ImageReview backend.
+	AllowedRegistries []string
+}
+
+// NewImagePolicyConfigFromMap creates an ImagePolicy from the supplied
+// ConfigMap data.
+func NewImagePolicyConfigFromMap(data map[string]string) (*ImagePolicy, error) {
+	nc := &ImagePolicy{
+		DefaultPolicy: "Off",
+	}
+
+	if v, ok := data["webhook-url"]; ok {
+		nc.WebhookURL = v
+	}
+	if v, ok := data["ca-bundle"]; ok {
+		nc.CABundle = []byte(v)
+	}
+	if v, ok := data["default-policy"]; ok {
+		nc.DefaultPolicy = v
+	}
+	if v, ok := data["allowed-registries"]; ok && v != "" {
+		for _, reg := range strings.Split(v, ",") {
+			nc.AllowedRegistries = append(nc.AllowedRegistries, strings.TrimSpace(reg))
+		}
+	}
+
+	return nc, nil
+}
+
+// NewImagePolicyConfigFromConfigMap creates an ImagePolicy from the supplied
+// ConfigMap.
+func NewImagePolicyConfigFromConfigMap(config *corev1.ConfigMap) (*ImagePolicy, error) {
+	return NewImagePolicyConfigFromMap(config.Data)
+}
+
+// IsAllowedRegistry reports whether image comes from a registry exempted
+// from ImageReview by this config.
+func (c *ImagePolicy) IsAllowedRegistry(image string) bool {
+	for _, reg := range c.AllowedRegistries {
+		if strings.HasPrefix(image, reg+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ConvertTo implements apis.Convertible. v1 is the only served version of
+// Revision today, so there is no other version for the conversion webhook
+// to convert to; this method exists only so Revision satisfies
+// apis.Convertible and the webhook rejects an unexpected target version
+// cleanly instead of panicking on a type assertion. It does not perform
+// the v1-to-duck conversion that ToDuckStatus below provides for
+// non-webhook callers.
+func (r *Revision) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1 is the only known version, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible. See ConvertTo.
+func (r *Revision) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1 is the only known version, got: %T", from)
+}
+
+// ToDuckStatus projects RevisionStatus's metav1.Condition conditions onto
+// the legacy duckv1.Status shape. This, not the conversion webhook above,
+// is how duck-typed consumers (the `kn` CLI, dashboards, generic duck
+// informers) that still expect duckv1.Status-embedded conditions read a
+// Revision's status during the migration away from that embed; callers
+// should use it directly rather than going through ConvertTo/ConvertFrom,
+// which do not implement it.
+func (rs *RevisionStatus) ToDuckStatus() duckv1.Status {
+	out := duckv1.Status{ObservedGeneration: rs.ObservedGeneration}
+	for _, c := range rs.Conditions {
+		out.Conditions = append(out.Conditions, apis.Condition{
+			Type:               apis.ConditionType(c.Type),
+			Status:             corev1.ConditionStatus(c.Status),
+			Severity:           conditionSeverity(c.Type),
+			LastTransitionTime: apis.VolatileTime{Inner: c.LastTransitionTime},
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+	}
+	return out
+}
+
+// conditionSeverity returns ConditionSeverityInfo for condition types that
+// are documented as informational-only (never gate RevisionConditionReady)
+// and ConditionSeverityError for everything else. Duck consumers aggregate
+// readiness by severity, so projecting ImagesAllowed/MaxDurationExceeded
+// as Error would make a Warn-policy or over-budget Revision read as
+// not-ready even though revCondSet never made them dependents.
+func conditionSeverity(t string) apis.ConditionSeverity {
+	switch t {
+	case string(RevisionConditionImagesAllowed), string(RevisionConditionMaxDurationExceeded):
+		return apis.ConditionSeverityInfo
+	default:
+		return apis.ConditionSeverityError
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"knative.dev/serving/pkg/apis/config"
+)
+
+// SetDefaults implements apis.Defaultable.
+func (r *Revision) SetDefaults(ctx context.Context) {
+	r.Spec.SetDefaults(ctx)
+}
+
+// SetDefaults implements apis.Defaultable.
+//
+// ContainerOverrides are only defaulted, never synthesized: with a single
+// serving container and no overrides, RevisionSpec.ContainerConcurrency
+// continues to apply directly, exactly as it did before ContainerOverrides
+// existed. An override only takes effect for the containers it names.
+func (rs *RevisionSpec) SetDefaults(ctx context.Context) {
+	for i := range rs.ContainerOverrides {
+		o := &rs.ContainerOverrides[i]
+		if o.ContainerConcurrency == nil && rs.ContainerConcurrency != nil {
+			v := *rs.ContainerConcurrency
+			o.ContainerConcurrency = &v
+		}
+		if o.ProtocolType == "" {
+			o.ProtocolType = protocolForContainer(rs, o.Name)
+		}
+	}
+
+	if ceiling := config.FromContextOrDefaults(ctx).MaxDurationSecondsCeiling; ceiling > 0 &&
+		rs.MaxDurationSeconds != nil && *rs.MaxDurationSeconds > ceiling {
+		// Never cap below TimeoutSeconds: validateMaxDurationSeconds requires
+		// MaxDurationSeconds >= TimeoutSeconds, and a cluster-wide ceiling
+		// shouldn't be able to turn an otherwise-valid Revision invalid just
+		// because the operator lowered it.
+		capped := ceiling
+		if rs.TimeoutSeconds != nil && *rs.TimeoutSeconds > capped {
+			capped = *rs.TimeoutSeconds
+		}
+		rs.MaxDurationSeconds = &capped
+	}
+}
+
+// protocolForContainer infers the ProtocolType for the named container
+// from its port name, defaulting to http1 when unset or unrecognized.
+func protocolForContainer(rs *RevisionSpec, name string) ProtocolType {
+	for _, c := range rs.Containers {
+		if c.Name != name {
+			continue
+		}
+		for _, p := range c.Ports {
+			switch ProtocolType(p.Name) {
+			case ProtocolH2C, ProtocolGRPC:
+				return ProtocolType(p.Name)
+			}
+		}
+	}
+	return ProtocolHTTP1
+}
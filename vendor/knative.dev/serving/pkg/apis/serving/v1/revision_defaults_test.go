@@ -0,0 +1,116 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"knative.dev/serving/pkg/apis/config"
+)
+
+func ptrInt64(v int64) *int64 { return &v }
+
+func TestRevisionSpec_SetDefaults_MaxDurationSecondsCeiling(t *testing.T) {
+	cases := []struct {
+		name           string
+		ceiling        int64
+		timeoutSeconds *int64
+		maxDuration    *int64
+		want           *int64
+	}{{
+		name:        "no ceiling configured leaves value untouched",
+		ceiling:     0,
+		maxDuration: ptrInt64(600),
+		want:        ptrInt64(600),
+	}, {
+		name:        "value under ceiling is untouched",
+		ceiling:     300,
+		maxDuration: ptrInt64(100),
+		want:        ptrInt64(100),
+	}, {
+		name:        "value over ceiling is capped",
+		ceiling:     300,
+		maxDuration: ptrInt64(600),
+		want:        ptrInt64(300),
+	}, {
+		name:           "ceiling below timeoutSeconds is floored at timeoutSeconds",
+		ceiling:        100,
+		timeoutSeconds: ptrInt64(200),
+		maxDuration:    ptrInt64(600),
+		want:           ptrInt64(200),
+	}, {
+		name:           "ceiling above timeoutSeconds is unaffected by flooring",
+		ceiling:        300,
+		timeoutSeconds: ptrInt64(200),
+		maxDuration:    ptrInt64(600),
+		want:           ptrInt64(300),
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := config.ToContext(context.Background(), &config.Defaults{MaxDurationSecondsCeiling: c.ceiling})
+			rs := &RevisionSpec{TimeoutSeconds: c.timeoutSeconds, MaxDurationSeconds: c.maxDuration}
+			rs.SetDefaults(ctx)
+
+			switch {
+			case c.want == nil && rs.MaxDurationSeconds != nil:
+				t.Fatalf("MaxDurationSeconds = %d, want nil", *rs.MaxDurationSeconds)
+			case c.want != nil && rs.MaxDurationSeconds == nil:
+				t.Fatalf("MaxDurationSeconds = nil, want %d", *c.want)
+			case c.want != nil && *rs.MaxDurationSeconds != *c.want:
+				t.Fatalf("MaxDurationSeconds = %d, want %d", *rs.MaxDurationSeconds, *c.want)
+			}
+		})
+	}
+}
+
+func TestRevisionSpec_SetDefaults_FlooredCeilingPassesValidation(t *testing.T) {
+	// A cluster ceiling lower than TimeoutSeconds must not make an
+	// otherwise-valid Revision fail validateMaxDurationSeconds.
+	ctx := config.ToContext(context.Background(), &config.Defaults{MaxDurationSecondsCeiling: 60})
+	rs := &RevisionSpec{
+		TimeoutSeconds:     ptrInt64(120),
+		MaxDurationSeconds: ptrInt64(600),
+	}
+	rs.SetDefaults(ctx)
+
+	if err := rs.validateMaxDurationSeconds(); err != nil {
+		t.Errorf("validateMaxDurationSeconds() = %v, want nil after flooring at timeoutSeconds", err)
+	}
+}
+
+func TestRevisionSpec_SetDefaults_ContainerConcurrencyNotAliased(t *testing.T) {
+	rs := &RevisionSpec{
+		ContainerConcurrency: ptrInt64(10),
+		ContainerOverrides:   []ContainerOverride{{Name: "user-container"}},
+	}
+	rs.SetDefaults(context.Background())
+
+	o := rs.ContainerOverrides[0]
+	if o.ContainerConcurrency == nil || *o.ContainerConcurrency != 10 {
+		t.Fatalf("override ContainerConcurrency = %v, want 10", o.ContainerConcurrency)
+	}
+	if o.ContainerConcurrency == rs.ContainerConcurrency {
+		t.Error("override ContainerConcurrency aliases RevisionSpec.ContainerConcurrency's pointer, want a copy")
+	}
+
+	*o.ContainerConcurrency = 99
+	if *rs.ContainerConcurrency != 10 {
+		t.Errorf("mutating the override's ContainerConcurrency changed RevisionSpec.ContainerConcurrency to %d, want unaffected 10", *rs.ContainerConcurrency)
+	}
+}
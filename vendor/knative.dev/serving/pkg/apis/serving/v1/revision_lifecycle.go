@@ -0,0 +1,222 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RevisionConditionSet mirrors the shape of apis.ConditionSet, but manages
+// the Kubernetes-standard []metav1.Condition schema instead of the
+// knative.dev/pkg/apis duck Condition type. Every mutation made through a
+// RevisionConditionSet stamps the condition's ObservedGeneration, and the
+// aggregated "Ready" condition only reports True once all of its
+// dependents have been observed at the current generation.
+type RevisionConditionSet struct {
+	happy      string
+	dependents []string
+}
+
+// NewRevisionConditionSet returns a RevisionConditionSet that aggregates
+// RevisionConditionReady from the given dependent condition types.
+func NewRevisionConditionSet(dependents ...string) RevisionConditionSet {
+	return RevisionConditionSet{
+		happy:      string(RevisionConditionReady),
+		dependents: dependents,
+	}
+}
+
+// revisionConditionManager is bound to a RevisionStatus and the generation
+// its conditions should be recorded against.
+type revisionConditionManager struct {
+	set        RevisionConditionSet
+	status     *RevisionStatus
+	generation int64
+}
+
+// Manage returns a manager that records mutations against rs at the given
+// generation, typically the reconciled resource's metadata.generation.
+func (r RevisionConditionSet) Manage(rs *RevisionStatus, generation int64) revisionConditionManager {
+	return revisionConditionManager{set: r, status: rs, generation: generation}
+}
+
+// InitializeConditions sets every managed condition (including the happy
+// condition) to Unknown if it is not already set.
+func (m revisionConditionManager) InitializeConditions() {
+	for _, t := range append(append([]string{}, m.set.dependents...), m.set.happy) {
+		if meta.FindStatusCondition(m.status.Conditions, t) == nil {
+			m.setCondition(t, metav1.ConditionUnknown, "", "")
+		}
+	}
+}
+
+// MarkTrue sets the condition type to True and recomputes the happy condition.
+func (m revisionConditionManager) MarkTrue(t string) {
+	m.setCondition(t, metav1.ConditionTrue, "", "")
+	m.recomputeHappiness()
+}
+
+// MarkFalse sets the condition type to False with the given reason and
+// message, and marks the happy condition False with the same reason.
+func (m revisionConditionManager) MarkFalse(t, reason, message string) {
+	m.setCondition(t, metav1.ConditionFalse, reason, message)
+	m.setCondition(m.set.happy, metav1.ConditionFalse, reason, message)
+}
+
+// MarkUnknown sets the condition type to Unknown with the given reason and
+// message, and marks the happy condition Unknown unless it is already False.
+func (m revisionConditionManager) MarkUnknown(t, reason, message string) {
+	m.setCondition(t, metav1.ConditionUnknown, reason, message)
+	if h := meta.FindStatusCondition(m.status.Conditions, m.set.happy); h == nil || h.Status != metav1.ConditionFalse {
+		m.setCondition(m.set.happy, metav1.ConditionUnknown, reason, message)
+	}
+}
+
+// IsHappy reports whether the happy condition is True and every dependent
+// condition was last observed at the manager's generation.
+func (m revisionConditionManager) IsHappy() bool {
+	happy := meta.FindStatusCondition(m.status.Conditions, m.set.happy)
+	if happy == nil || happy.Status != metav1.ConditionTrue || happy.ObservedGeneration != m.generation {
+		return false
+	}
+	for _, t := range m.set.dependents {
+		c := meta.FindStatusCondition(m.status.Conditions, t)
+		if c == nil || c.Status != metav1.ConditionTrue || c.ObservedGeneration != m.generation {
+			return false
+		}
+	}
+	return true
+}
+
+func (m revisionConditionManager) setCondition(t string, status metav1.ConditionStatus, reason, message string) {
+	if reason == "" {
+		reason = "Unknown"
+		if status == metav1.ConditionTrue {
+			reason = t
+		}
+	}
+	meta.SetStatusCondition(&m.status.Conditions, metav1.Condition{
+		Type:               t,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: m.generation,
+	})
+	m.status.ObservedGeneration = m.generation
+}
+
+// recomputeHappiness marks the happy condition True only if every
+// dependent is True as of the manager's generation; otherwise it leaves
+// the happy condition unchanged.
+func (m revisionConditionManager) recomputeHappiness() {
+	for _, t := range m.set.dependents {
+		c := meta.FindStatusCondition(m.status.Conditions, t)
+		if c == nil || c.Status != metav1.ConditionTrue || c.ObservedGeneration != m.generation {
+			return
+		}
+	}
+	m.setCondition(m.set.happy, metav1.ConditionTrue, "", "")
+}
+
+// revCondSet is the condition set used by all Revisions.
+var revCondSet = NewRevisionConditionSet(
+	string(RevisionConditionResourcesAvailable),
+	string(RevisionConditionContainerHealthy),
+	string(RevisionConditionActive),
+	string(RevisionConditionAutoscalerReady),
+)
+
+// InitializeConditions sets the initial values to the conditions.
+func (rs *RevisionStatus) InitializeConditions(generation int64) {
+	revCondSet.Manage(rs, generation).InitializeConditions()
+}
+
+// MarkActive marks the Active condition True.
+func (rs *RevisionStatus) MarkActive(generation int64) {
+	revCondSet.Manage(rs, generation).MarkTrue(string(RevisionConditionActive))
+}
+
+// MarkInactive marks the Active condition False with the given reason and message.
+func (rs *RevisionStatus) MarkInactive(generation int64, reason, message string) {
+	revCondSet.Manage(rs, generation).MarkFalse(string(RevisionConditionActive), reason, message)
+}
+
+// MarkAutoscalerReady marks the AutoscalerReady condition True, indicating
+// the Revision's PodAutoscaler has been created and reports itself ready.
+func (rs *RevisionStatus) MarkAutoscalerReady(generation int64) {
+	revCondSet.Manage(rs, generation).MarkTrue(string(RevisionConditionAutoscalerReady))
+}
+
+// MarkAutoscalerNotReady marks the AutoscalerReady condition False with the
+// given reason and message, e.g. while waiting for the PodAutoscaler to be
+// created or to report readiness.
+func (rs *RevisionStatus) MarkAutoscalerNotReady(generation int64, reason, message string) {
+	revCondSet.Manage(rs, generation).MarkFalse(string(RevisionConditionAutoscalerReady), reason, message)
+}
+
+// IsReady reports whether RevisionConditionReady is True and every
+// dependent condition was last observed at generation.
+func (rs *RevisionStatus) IsReady(generation int64) bool {
+	return revCondSet.Manage(rs, generation).IsHappy()
+}
+
+// GetCondition returns the condition currently associated with the given
+// type, or nil if it is not present.
+func (rs *RevisionStatus) GetCondition(t string) *metav1.Condition {
+	return meta.FindStatusCondition(rs.Conditions, t)
+}
+
+// MarkImagesAllowed marks RevisionConditionImagesAllowed True. It is
+// informational only and is not part of revCondSet, so it never gates
+// RevisionConditionReady.
+func (rs *RevisionStatus) MarkImagesAllowed(generation int64) {
+	meta.SetStatusCondition(&rs.Conditions, metav1.Condition{
+		Type:               string(RevisionConditionImagesAllowed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(RevisionConditionImagesAllowed),
+		ObservedGeneration: generation,
+	})
+}
+
+// MarkImagesDisallowed marks RevisionConditionImagesAllowed False with the
+// given reason and message, e.g. when the cluster's ImageReview backend
+// rejected one of the Revision's container images under ImagePolicyWarn.
+func (rs *RevisionStatus) MarkImagesDisallowed(generation int64, reason, message string) {
+	meta.SetStatusCondition(&rs.Conditions, metav1.Condition{
+		Type:               string(RevisionConditionImagesAllowed),
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
+
+// MarkMaxDurationExceeded marks RevisionConditionMaxDurationExceeded False
+// with the given reason and message, recording that the queue-proxy has
+// terminated requests on this Revision for exceeding
+// Spec.MaxDurationSeconds. It is informational only and is not part of
+// revCondSet, so it never gates RevisionConditionReady.
+func (rs *RevisionStatus) MarkMaxDurationExceeded(generation int64, reason, message string) {
+	meta.SetStatusCondition(&rs.Conditions, metav1.Condition{
+		Type:               string(RevisionConditionMaxDurationExceeded),
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
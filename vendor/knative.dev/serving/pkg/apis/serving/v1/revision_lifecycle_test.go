@@ -0,0 +1,127 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "testing"
+
+func TestRevisionIsReady(t *testing.T) {
+	const generation = int64(2)
+
+	cases := []struct {
+		name string
+		rs   func() *RevisionStatus
+		want bool
+	}{{
+		name: "freshly initialized",
+		rs: func() *RevisionStatus {
+			rs := &RevisionStatus{}
+			rs.InitializeConditions(generation)
+			return rs
+		},
+		want: false,
+	}, {
+		name: "all dependents true at current generation",
+		rs: func() *RevisionStatus {
+			rs := &RevisionStatus{}
+			rs.InitializeConditions(generation)
+			rs.MarkActive(generation)
+			rs.MarkAutoscalerReady(generation)
+			revCondSet.Manage(rs, generation).MarkTrue(string(RevisionConditionResourcesAvailable))
+			revCondSet.Manage(rs, generation).MarkTrue(string(RevisionConditionContainerHealthy))
+			return rs
+		},
+		want: true,
+	}, {
+		name: "one dependent false",
+		rs: func() *RevisionStatus {
+			rs := &RevisionStatus{}
+			rs.InitializeConditions(generation)
+			rs.MarkActive(generation)
+			rs.MarkAutoscalerReady(generation)
+			revCondSet.Manage(rs, generation).MarkTrue(string(RevisionConditionResourcesAvailable))
+			rs.MarkInactive(generation, "NotActive", "the revision is not currently active")
+			return rs
+		},
+		want: false,
+	}, {
+		name: "all dependents true but stale generation",
+		rs: func() *RevisionStatus {
+			rs := &RevisionStatus{}
+			rs.InitializeConditions(generation - 1)
+			rs.MarkActive(generation - 1)
+			rs.MarkAutoscalerReady(generation - 1)
+			revCondSet.Manage(rs, generation-1).MarkTrue(string(RevisionConditionResourcesAvailable))
+			revCondSet.Manage(rs, generation-1).MarkTrue(string(RevisionConditionContainerHealthy))
+			return rs
+		},
+		want: false,
+	}, {
+		name: "informational conditions don't affect happiness",
+		rs: func() *RevisionStatus {
+			rs := &RevisionStatus{}
+			rs.InitializeConditions(generation)
+			rs.MarkActive(generation)
+			rs.MarkAutoscalerReady(generation)
+			revCondSet.Manage(rs, generation).MarkTrue(string(RevisionConditionResourcesAvailable))
+			revCondSet.Manage(rs, generation).MarkTrue(string(RevisionConditionContainerHealthy))
+			rs.MarkImagesDisallowed(generation, "Disallowed", "image rejected by ImageReview")
+			rs.MarkMaxDurationExceeded(generation, "MaxDurationExceeded", "request exceeded budget")
+			return rs
+		},
+		want: true,
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rs := c.rs()
+			if got := rs.IsReady(generation); got != c.want {
+				t.Errorf("IsReady(%d) = %v, want %v", generation, got, c.want)
+			}
+		})
+	}
+}
+
+func TestRevisionIsReady_PartiallyReconciled(t *testing.T) {
+	rs := &RevisionStatus{}
+	rs.InitializeConditions(1)
+	rs.MarkActive(1)
+	rs.MarkAutoscalerReady(1)
+	revCondSet.Manage(rs, 1).MarkTrue(string(RevisionConditionResourcesAvailable))
+	revCondSet.Manage(rs, 1).MarkTrue(string(RevisionConditionContainerHealthy))
+
+	if !rs.IsReady(1) {
+		t.Fatal("IsReady(1) = false, want true before the spec changes again")
+	}
+
+	// Bump the generation without reconciling yet: the previous dependents
+	// are now stale and Ready must not be reported at the new generation.
+	if rs.IsReady(2) {
+		t.Error("IsReady(2) = true, want false: dependents still observed at generation 1")
+	}
+
+	revCondSet.Manage(rs, 2).MarkTrue(string(RevisionConditionResourcesAvailable))
+	if rs.IsReady(2) {
+		t.Error("IsReady(2) = true, want false: only one of four dependents reconciled at generation 2")
+	}
+
+	revCondSet.Manage(rs, 2).MarkTrue(string(RevisionConditionContainerHealthy))
+	rs.MarkActive(2)
+	rs.MarkAutoscalerReady(2)
+	if !rs.IsReady(2) {
+		t.Error("IsReady(2) = false, want true once every dependent reconciled at generation 2")
+	}
+}
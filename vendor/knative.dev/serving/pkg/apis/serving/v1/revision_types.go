@@ -20,7 +20,6 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
-	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmeta"
 )
 
@@ -56,11 +55,15 @@ var (
 
 	// Check that we can create OwnerReferences to a Revision.
 	_ kmeta.OwnerRefable = (*Revision)(nil)
-
-	// Check that the type conforms to the duck Knative Resource shape.
-	_ duckv1.KRShaped = (*Revision)(nil)
 )
 
+// Revision no longer implements duckv1.KRShaped: its conditions are
+// metav1.Condition, not the duck apis.Condition type KRShaped callers
+// expect. Consumers that still need the duck-typed shape (printers,
+// `kn`, dashboards) call RevisionStatus.ToDuckStatus, which projects
+// Status.Conditions onto duckv1.Status; ConvertTo does not perform that
+// projection. See revision_conversion.go.
+
 // RevisionTemplateSpec describes the data a revision should have when created from a template.
 // Based on: https://github.com/kubernetes/api/blob/e771f807/core/v1/types.go#L3179-L3190
 type RevisionTemplateSpec struct {
@@ -93,8 +96,85 @@ type RevisionSpec struct {
 	// to stay open.
 	// +optional
 	MaxDurationSeconds *int64 `json:"maxDurationSeconds,omitempty"`
+
+	// ImagePolicy controls how container images in this Revision are
+	// checked against the cluster's configured ImageReview backend during
+	// validation. Defaults to the cluster-wide default policy when unset.
+	// +optional
+	ImagePolicy ImagePolicyType `json:"imagePolicy,omitempty"`
+
+	// ContainerOverrides holds per-container overrides of the concurrency
+	// and protocol settings that otherwise apply Revision-wide, keyed by
+	// container name. This lets a multi-container Revision autoscale a
+	// gRPC sidecar independently of an HTTP/1 main container. Each entry
+	// must reference a container present in PodSpec.Containers.
+	//
+	// Named ContainerOverrides, not Containers, so it doesn't shadow the
+	// inlined PodSpec.Containers field.
+	// +optional
+	ContainerOverrides []ContainerOverride `json:"containerOverrides,omitempty"`
+}
+
+// ContainerOverride carries per-container autoscaling settings for a
+// container of RevisionSpec.PodSpec.Containers.
+type ContainerOverride struct {
+	// Name is the name of the container in PodSpec.Containers this
+	// override applies to.
+	Name string `json:"name"`
+
+	// ContainerConcurrency overrides RevisionSpec.ContainerConcurrency for
+	// this container.
+	// +optional
+	ContainerConcurrency *int64 `json:"containerConcurrency,omitempty"`
+
+	// ProtocolType overrides the protocol inferred from this container's
+	// port name.
+	// +optional
+	ProtocolType ProtocolType `json:"protocolType,omitempty"`
+
+	// TargetUtilizationPercentage overrides the cluster-wide target
+	// utilization percentage used by the autoscaler for this container.
+	// +optional
+	TargetUtilizationPercentage *int32 `json:"targetUtilizationPercentage,omitempty"`
 }
 
+// ProtocolType is the enumeration of app-level protocols a container may
+// serve, used by both RevisionSpec.ContainerOverrides and the
+// PodAutoscaler created for the Revision.
+type ProtocolType string
+
+const (
+	// ProtocolHTTP1 is the default protocol, inferred from a container
+	// port named "http1" or left unnamed.
+	ProtocolHTTP1 ProtocolType = "http1"
+
+	// ProtocolH2C is the HTTP/2 cleartext protocol, inferred from a
+	// container port named "h2c".
+	ProtocolH2C ProtocolType = "h2c"
+
+	// ProtocolGRPC is the gRPC protocol, inferred from a container port
+	// named "grpc".
+	ProtocolGRPC ProtocolType = "grpc"
+)
+
+// ImagePolicyType is the enumeration of ImageReview enforcement modes for
+// a Revision's container images.
+type ImagePolicyType string
+
+const (
+	// ImagePolicyEnforce rejects the Revision at admission if any image is
+	// disallowed by the ImageReview backend.
+	ImagePolicyEnforce ImagePolicyType = "Enforce"
+
+	// ImagePolicyWarn admits the Revision regardless of the ImageReview
+	// result, but surfaces a disallowed image via
+	// RevisionConditionImagesAllowed.
+	ImagePolicyWarn ImagePolicyType = "Warn"
+
+	// ImagePolicyOff skips ImageReview entirely for this Revision.
+	ImagePolicyOff ImagePolicyType = "Off"
+)
+
 const (
 	// RevisionConditionReady is set when the revision is starting to materialize
 	// runtime resources, and becomes true when those resources are ready.
@@ -109,6 +189,22 @@ const (
 
 	// RevisionConditionActive is set when the revision is receiving traffic.
 	RevisionConditionActive apis.ConditionType = "Active"
+
+	// RevisionConditionAutoscalerReady is set when the revision's PodAutoscaler
+	// has been created and reports itself as ready.
+	RevisionConditionAutoscalerReady apis.ConditionType = "AutoscalerReady"
+
+	// RevisionConditionImagesAllowed is set to False when the Revision's
+	// ImagePolicy is Warn and at least one container image was rejected by
+	// the configured ImageReview backend. It never blocks readiness.
+	RevisionConditionImagesAllowed apis.ConditionType = "ImagesAllowed"
+
+	// RevisionConditionMaxDurationExceeded is set to False when the queue-proxy
+	// reports that a request was terminated for exceeding
+	// Spec.MaxDurationSeconds, so Route controllers can shed traffic from a
+	// Revision whose backends chronically exceed budget. It never blocks
+	// readiness.
+	RevisionConditionMaxDurationExceeded apis.ConditionType = "MaxDurationExceeded"
 )
 
 // IsRevisionCondition returns true if the ConditionType is a revision condition type
@@ -118,15 +214,34 @@ func IsRevisionCondition(t apis.ConditionType) bool {
 		RevisionConditionReady,
 		RevisionConditionResourcesAvailable,
 		RevisionConditionContainerHealthy,
-		RevisionConditionActive:
+		RevisionConditionActive,
+		RevisionConditionAutoscalerReady,
+		RevisionConditionImagesAllowed,
+		RevisionConditionMaxDurationExceeded:
 		return true
 	}
 	return false
 }
 
 // RevisionStatus communicates the observed state of the Revision (from the controller).
+//
+// Conditions use the Kubernetes-standard metav1.Condition schema rather than
+// the knative.dev/pkg/apis duck Condition type, so that every mutation
+// records the ObservedGeneration it was computed against. IsReady(generation)
+// only reports true once the Ready condition and every dependent
+// condition's ObservedGeneration match the generation it is given.
 type RevisionStatus struct {
-	duckv1.Status `json:",inline"`
+	// ObservedGeneration is the 'Generation' of the Revision that was last
+	// processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions is the set of conditions describing the current state of
+	// the Revision.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 
 	// LogURL specifies the generated logging url for this particular revision
 	// based on the revision url template specified in the controller's config.
@@ -157,6 +272,36 @@ type RevisionStatus struct {
 	// DesiredReplicas reflects the desired amount of pods running this revision.
 	// +optional
 	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+
+	// PodAutoscalerName is the name of the PodAutoscaler the reconciler
+	// created for this Revision. It owns scaling decisions for the
+	// Revision's underlying Deployment.
+	// +optional
+	PodAutoscalerName string `json:"podAutoscalerName,omitempty"`
+
+	// RequestStats summarizes request lifetimes observed by the
+	// queue-proxy, including MaxDurationSeconds terminations.
+	// +optional
+	RequestStats *RequestStats `json:"requestStats,omitempty"`
+}
+
+// RequestStats summarizes request-lifetime observations reported by the
+// queue-proxy for a Revision's Pods.
+type RequestStats struct {
+	// LongestObservedRequestSeconds is the longest request duration
+	// observed across the Revision's Pods.
+	// +optional
+	LongestObservedRequestSeconds *int64 `json:"longestObservedRequestSeconds,omitempty"`
+
+	// RequestsTerminatedByMaxDuration counts requests the queue-proxy has
+	// terminated for exceeding Spec.MaxDurationSeconds.
+	// +optional
+	RequestsTerminatedByMaxDuration *int64 `json:"requestsTerminatedByMaxDuration,omitempty"`
+
+	// LastMaxDurationTerminationTime is when the queue-proxy most recently
+	// terminated a request for exceeding Spec.MaxDurationSeconds.
+	// +optional
+	LastMaxDurationTerminationTime *metav1.Time `json:"lastMaxDurationTerminationTime,omitempty"`
 }
 
 // ContainerStatus holds the information of container name and image digest value
@@ -174,8 +319,3 @@ type RevisionList struct {
 
 	Items []Revision `json:"items"`
 }
-
-// GetStatus retrieves the status of the Revision. Implements the KRShaped interface.
-func (t *Revision) GetStatus() *duckv1.Status {
-	return &t.Status.Status
-}
@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable.
+func (r *Revision) Validate(ctx context.Context) *apis.FieldError {
+	return r.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable.
+//
+// Image validation is deliberately not invoked here: it requires an
+// imagepolicy.Reviewer pulled from ctx by the webhook (see
+// knative.dev/serving/pkg/webhook/imagepolicy), which would make this
+// package depend on the webhook package. The Revision admission webhook
+// calls imagepolicy.ValidateContainerImages directly and folds the result
+// into the FieldError returned to the API server.
+func (rs *RevisionSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch rs.ImagePolicy {
+	case "", ImagePolicyEnforce, ImagePolicyWarn, ImagePolicyOff:
+		// Valid.
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(rs.ImagePolicy, "imagePolicy"))
+	}
+
+	errs = errs.Also(rs.validateContainerOverrides())
+	errs = errs.Also(rs.validateMaxDurationSeconds())
+
+	return errs
+}
+
+// validateMaxDurationSeconds rejects a MaxDurationSeconds shorter than
+// TimeoutSeconds: the request routing layer would give up waiting for a
+// reply before the max-duration budget it's meant to bound even starts
+// ticking.
+func (rs *RevisionSpec) validateMaxDurationSeconds() *apis.FieldError {
+	if rs.MaxDurationSeconds == nil || rs.TimeoutSeconds == nil {
+		return nil
+	}
+	if *rs.MaxDurationSeconds < *rs.TimeoutSeconds {
+		return apis.ErrInvalidValue(*rs.MaxDurationSeconds, "maxDurationSeconds",
+			"must be greater than or equal to timeoutSeconds")
+	}
+	return nil
+}
+
+// validateContainerOverrides ensures every ContainerOverrides entry names a
+// container that actually appears in PodSpec.Containers.
+func (rs *RevisionSpec) validateContainerOverrides() *apis.FieldError {
+	names := make(map[string]struct{}, len(rs.Containers))
+	for _, c := range rs.Containers {
+		names[c.Name] = struct{}{}
+	}
+
+	var errs *apis.FieldError
+	for i, o := range rs.ContainerOverrides {
+		if _, ok := names[o.Name]; !ok {
+			errs = errs.Also(apis.ErrInvalidValue(o.Name, "name").ViaFieldIndex("containerOverrides", i))
+		}
+		switch o.ProtocolType {
+		case "", ProtocolHTTP1, ProtocolH2C, ProtocolGRPC:
+			// Valid.
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(o.ProtocolType, "protocolType").ViaFieldIndex("containerOverrides", i))
+		}
+	}
+	return errs
+}
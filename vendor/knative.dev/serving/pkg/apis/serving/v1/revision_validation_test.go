@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestRevisionSpec_ValidateMaxDurationSeconds(t *testing.T) {
+	cases := []struct {
+		name        string
+		timeout     *int64
+		maxDuration *int64
+		wantErr     bool
+	}{{
+		name: "both unset",
+	}, {
+		name:        "timeout unset",
+		maxDuration: ptrInt64(60),
+	}, {
+		name:    "maxDuration unset",
+		timeout: ptrInt64(60),
+	}, {
+		name:        "maxDuration equal to timeout",
+		timeout:     ptrInt64(60),
+		maxDuration: ptrInt64(60),
+	}, {
+		name:        "maxDuration greater than timeout",
+		timeout:     ptrInt64(60),
+		maxDuration: ptrInt64(120),
+	}, {
+		name:        "maxDuration less than timeout",
+		timeout:     ptrInt64(120),
+		maxDuration: ptrInt64(60),
+		wantErr:     true,
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rs := &RevisionSpec{TimeoutSeconds: c.timeout, MaxDurationSeconds: c.maxDuration}
+			err := rs.validateMaxDurationSeconds()
+			if (err != nil) != c.wantErr {
+				t.Errorf("validateMaxDurationSeconds() = %v, wantErr %v", err, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestRevisionSpec_ValidateContainerOverrides_ProtocolType(t *testing.T) {
+	cases := []struct {
+		name     string
+		protocol ProtocolType
+		wantErr  bool
+	}{
+		{name: "unset", protocol: ""},
+		{name: "http1", protocol: ProtocolHTTP1},
+		{name: "h2c", protocol: ProtocolH2C},
+		{name: "grpc", protocol: ProtocolGRPC},
+		{name: "bogus", protocol: "bogus", wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rs := &RevisionSpec{
+				ContainerOverrides: []ContainerOverride{{Name: "user-container", ProtocolType: c.protocol}},
+			}
+			rs.Containers = []corev1.Container{{Name: "user-container"}}
+			err := rs.validateContainerOverrides()
+			if (err != nil) != c.wantErr {
+				t.Errorf("validateContainerOverrides() = %v, wantErr %v", err, c.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "knative.dev/serving/pkg/apis/serving/v1"
+	"knative.dev/serving/pkg/reconciler/revision/resources"
+)
+
+// reconcilePA creates the PodAutoscaler owned by rev if it does not yet
+// exist, and updates rev.Status accordingly. It is called once the
+// Revision's underlying resources are ready to be autoscaled.
+//
+// TODO: wire this into the Revision reconciler's main control loop; the
+// Reconciler.client and Reconciler.podAutoscalerLister fields are supplied
+// by the generated injection scaffolding for this controller.
+func (c *Reconciler) reconcilePA(ctx context.Context, rev *v1.Revision) error {
+	pa, err := c.podAutoscalerLister.PodAutoscalers(rev.Namespace).Get(rev.Name)
+	if apierrs.IsNotFound(err) {
+		pa = resources.MakePA(rev)
+		pa, err = c.client.AutoscalingV1alpha1().PodAutoscalers(rev.Namespace).Create(ctx, pa, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to create PodAutoscaler %q: %w", rev.Name, err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to get PodAutoscaler %q: %w", rev.Name, err)
+	}
+
+	rev.Status.PodAutoscalerName = pa.Name
+	if pa.Status.IsReady() {
+		rev.Status.MarkAutoscalerReady(rev.Generation)
+	} else {
+		rev.Status.MarkAutoscalerNotReady(rev.Generation, "Autoscaling", "The PodAutoscaler is not yet ready.")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package requeststats implements the status-updater controller that folds
+// request-lifetime observations reported by each Pod's queue-proxy into
+// the owning Revision's Status.RequestStats.
+package requeststats
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// Observation is one queue-proxy's report of request-lifetime behavior
+// since it last reported, for a single Pod of a Revision.
+type Observation struct {
+	// LongestRequestSeconds is the longest request duration the
+	// queue-proxy observed in this reporting period.
+	LongestRequestSeconds int64
+
+	// TerminatedByMaxDuration counts requests the queue-proxy terminated
+	// in this reporting period for exceeding MaxDurationSeconds.
+	TerminatedByMaxDuration int64
+}
+
+// Apply merges obs into rev.Status.RequestStats, taking the max of
+// LongestObservedRequestSeconds across reporting periods and summing the
+// MaxDurationSeconds termination counter. When obs reports any
+// terminations, it also marks RevisionConditionMaxDurationExceeded; the
+// reconciler is responsible for persisting rev.Status afterward.
+func Apply(rev *v1.Revision, obs Observation, now time.Time) {
+	rs := rev.Status.RequestStats
+	if rs == nil {
+		rs = &v1.RequestStats{}
+		rev.Status.RequestStats = rs
+	}
+
+	if rs.LongestObservedRequestSeconds == nil || *rs.LongestObservedRequestSeconds < obs.LongestRequestSeconds {
+		rs.LongestObservedRequestSeconds = &obs.LongestRequestSeconds
+	}
+
+	if obs.TerminatedByMaxDuration <= 0 {
+		return
+	}
+
+	total := obs.TerminatedByMaxDuration
+	if rs.RequestsTerminatedByMaxDuration != nil {
+		total += *rs.RequestsTerminatedByMaxDuration
+	}
+	rs.RequestsTerminatedByMaxDuration = &total
+
+	t := metav1.NewTime(now)
+	rs.LastMaxDurationTerminationTime = &t
+
+	rev.Status.MarkMaxDurationExceeded(rev.Generation, "MaxDurationExceeded",
+		"The queue-proxy terminated one or more requests for exceeding spec.maxDurationSeconds.")
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requeststats
+
+import (
+	"testing"
+	"time"
+
+	v1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestApply_LongestObservedRequestSeconds(t *testing.T) {
+	now := time.Unix(1000, 0)
+	rev := &v1.Revision{}
+
+	Apply(rev, Observation{LongestRequestSeconds: 5}, now)
+	if got := *rev.Status.RequestStats.LongestObservedRequestSeconds; got != 5 {
+		t.Fatalf("LongestObservedRequestSeconds = %d, want 5", got)
+	}
+
+	// A shorter observation in a later period must not lower the max.
+	Apply(rev, Observation{LongestRequestSeconds: 2}, now)
+	if got := *rev.Status.RequestStats.LongestObservedRequestSeconds; got != 5 {
+		t.Fatalf("LongestObservedRequestSeconds = %d, want 5 (max preserved)", got)
+	}
+
+	// A longer observation raises the max.
+	Apply(rev, Observation{LongestRequestSeconds: 9}, now)
+	if got := *rev.Status.RequestStats.LongestObservedRequestSeconds; got != 9 {
+		t.Fatalf("LongestObservedRequestSeconds = %d, want 9", got)
+	}
+}
+
+func TestApply_RequestsTerminatedByMaxDurationSums(t *testing.T) {
+	now := time.Unix(1000, 0)
+	rev := &v1.Revision{}
+
+	Apply(rev, Observation{TerminatedByMaxDuration: 3}, now)
+	Apply(rev, Observation{TerminatedByMaxDuration: 4}, now)
+
+	if got := *rev.Status.RequestStats.RequestsTerminatedByMaxDuration; got != 7 {
+		t.Fatalf("RequestsTerminatedByMaxDuration = %d, want 7 (3+4)", got)
+	}
+}
+
+func TestApply_NoTerminations_LeavesTerminationFieldsUntouched(t *testing.T) {
+	now := time.Unix(1000, 0)
+	rev := &v1.Revision{}
+
+	Apply(rev, Observation{TerminatedByMaxDuration: 2}, now)
+	firstTime := rev.Status.RequestStats.LastMaxDurationTerminationTime
+
+	Apply(rev, Observation{LongestRequestSeconds: 1}, now.Add(time.Minute))
+
+	if got := *rev.Status.RequestStats.RequestsTerminatedByMaxDuration; got != 2 {
+		t.Fatalf("RequestsTerminatedByMaxDuration = %d, want unchanged 2", got)
+	}
+	if rev.Status.RequestStats.LastMaxDurationTerminationTime.Time != firstTime.Time {
+		t.Error("LastMaxDurationTerminationTime changed on an observation with no terminations")
+	}
+}
+
+func TestApply_TerminationsMarkMaxDurationExceeded(t *testing.T) {
+	now := time.Unix(1000, 0)
+	rev := &v1.Revision{}
+	rev.Generation = 3
+
+	Apply(rev, Observation{TerminatedByMaxDuration: 1}, now)
+
+	cond := rev.Status.GetCondition(string(v1.RevisionConditionMaxDurationExceeded))
+	if cond == nil {
+		t.Fatal("RevisionConditionMaxDurationExceeded not set after a termination was observed")
+	}
+	if cond.ObservedGeneration != 3 {
+		t.Errorf("ObservedGeneration = %d, want 3", cond.ObservedGeneration)
+	}
+}
+
+func TestApply_NoTerminations_DoesNotMarkMaxDurationExceeded(t *testing.T) {
+	now := time.Unix(1000, 0)
+	rev := &v1.Revision{}
+
+	Apply(rev, Observation{LongestRequestSeconds: 1}, now)
+
+	if cond := rev.Status.GetCondition(string(v1.RevisionConditionMaxDurationExceeded)); cond != nil {
+		t.Errorf("RevisionConditionMaxDurationExceeded = %v, want unset with zero terminations", cond)
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resources defines the Kubernetes resources owned by the Revision
+// reconciler, and helpers that build them from a Revision.
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/kmeta"
+	autoscalingv1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
+	v1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// MakePA creates a PodAutoscaler for the Revision, deriving Reachability
+// and ProtocolType from the Revision's spec. The returned PA is owned by
+// the Revision and targets its Deployment.
+func MakePA(rev *v1.Revision) *autoscalingv1alpha1.PodAutoscaler {
+	return &autoscalingv1alpha1.PodAutoscaler{
+		ObjectMeta: pAMeta(rev),
+		Spec: autoscalingv1alpha1.PodAutoscalerSpec{
+			ScaleTargetRef: corev1.ObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       rev.Name + "-deployment",
+			},
+			ContainerConcurrency: containerConcurrency(rev),
+			Reachability:         reachability(rev),
+			ProtocolType:         protocolType(rev),
+		},
+	}
+}
+
+func pAMeta(rev *v1.Revision) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            rev.Name,
+		Namespace:       rev.Namespace,
+		Labels:          rev.Labels,
+		Annotations:     rev.Annotations,
+		OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(rev)},
+	}
+}
+
+// servingContainer returns the container that receives traffic, along with
+// its ContainerOverride if one was given. Serving Revisions today still
+// scale as a single Pod, so only the serving container's settings govern
+// the PodAutoscaler; a sidecar's own ContainerOverride is consulted by the
+// queue-proxy directly rather than by the PA.
+//
+// The serving container is the one with a declared Ports entry: queue-proxy
+// only proxies traffic to a container that exposes a port, so a sidecar
+// (e.g. a gRPC sidecar with no exposed port) is never mistaken for it.
+// Containers[0] is used only as a last-resort fallback when no container
+// declares a port, matching the single-container case that predates
+// ContainerOverrides.
+func servingContainer(rev *v1.Revision) (corev1.Container, *v1.ContainerOverride) {
+	var serving corev1.Container
+	if len(rev.Spec.Containers) > 0 {
+		serving = rev.Spec.Containers[0]
+	}
+	for _, c := range rev.Spec.Containers {
+		if len(c.Ports) > 0 {
+			serving = c
+			break
+		}
+	}
+	for i, o := range rev.Spec.ContainerOverrides {
+		if o.Name == serving.Name {
+			return serving, &rev.Spec.ContainerOverrides[i]
+		}
+	}
+	return serving, nil
+}
+
+// containerConcurrency resolves the effective ContainerConcurrency for the
+// Revision's serving container: its ContainerOverride if set, otherwise
+// the Revision-wide value. With a single serving container and no
+// overrides, the top-level RevisionSpec.ContainerConcurrency still applies
+// directly.
+func containerConcurrency(rev *v1.Revision) int64 {
+	if _, override := servingContainer(rev); override != nil && override.ContainerConcurrency != nil {
+		return *override.ContainerConcurrency
+	}
+	if rev.Spec.ContainerConcurrency == nil {
+		return 0
+	}
+	return *rev.Spec.ContainerConcurrency
+}
+
+// reachability reports whether the Revision is known to be unreachable,
+// i.e. known NOT to be referenced by any Route. MakePA has no Route
+// information to go on yet, so it defaults to Unknown rather than
+// Unreachable: PodAutoscaler.IsReachable treats Unknown as
+// reachable, while Unreachable is reserved for once the Revision
+// reconciler can positively confirm no Route references the Revision.
+// Marking every Revision Unreachable here would scale-to-zero
+// route-referenced Revisions that simply haven't had their Route
+// wiring applied yet.
+func reachability(rev *v1.Revision) autoscalingv1alpha1.ReachabilityType {
+	return autoscalingv1alpha1.ReachabilityUnknown
+}
+
+// protocolType resolves the effective protocol for the Revision's serving
+// container: its ContainerOverride if set, otherwise the protocol
+// inferred from the container's port name, defaulting to http1.
+//
+// The PodAutoscaler's ProtocolType only distinguishes http1 from h2c
+// (it governs probing and autoscaler metric transport, both of which are
+// keep-alive-HTTP/1.1-vs-prior-knowledge-HTTP/2 concerns); v1.ProtocolGRPC
+// runs over HTTP/2 cleartext, so it maps to ProtocolH2C here rather than
+// being passed through, which would produce a PA its own Validate rejects.
+func protocolType(rev *v1.Revision) autoscalingv1alpha1.ProtocolType {
+	serving, override := servingContainer(rev)
+	effective := v1.ProtocolHTTP1
+	if override != nil && override.ProtocolType != "" {
+		effective = override.ProtocolType
+	} else {
+		for _, p := range serving.Ports {
+			if v1.ProtocolType(p.Name) == v1.ProtocolH2C || v1.ProtocolType(p.Name) == v1.ProtocolGRPC {
+				effective = v1.ProtocolType(p.Name)
+				break
+			}
+		}
+	}
+	if effective == v1.ProtocolGRPC {
+		return autoscalingv1alpha1.ProtocolH2C
+	}
+	return autoscalingv1alpha1.ProtocolType(effective)
+}
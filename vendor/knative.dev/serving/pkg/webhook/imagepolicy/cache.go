@@ -0,0 +1,87 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy
+
+import (
+	"fmt"
+	"sync"
+)
+
+// verdict is the cached outcome of reviewing one image digest.
+type verdict struct {
+	allowed bool
+	reason  string
+}
+
+// Resolver resolves an image reference as it appears in PodSpec (which may
+// be a mutable tag, e.g. "gcr.io/foo/bar:latest") to its immutable digest
+// form (e.g. "gcr.io/foo/bar@sha256:..."). Revision validation runs before
+// the reconciler populates RevisionStatus.ContainerStatuses, so the digest
+// used for ImageReview and for keying CachingReviewer's cache has to be
+// resolved here rather than read off the Revision.
+type Resolver interface {
+	Resolve(image string) (digest string, err error)
+}
+
+// CachingReviewer wraps a Reviewer with an in-memory cache keyed by the
+// image's resolved digest rather than its raw spec reference, so that
+// repeated Revisions referencing the same digest skip the network
+// round-trip, while a mutable tag repushed to a new image is re-resolved
+// and re-reviewed instead of reusing a stale verdict forever.
+type CachingReviewer struct {
+	resolver Resolver
+	next     Reviewer
+
+	mu    sync.RWMutex
+	cache map[string]verdict
+}
+
+// NewCachingReviewer wraps next with a digest-keyed cache, resolving each
+// image reference through resolver before consulting the cache.
+func NewCachingReviewer(resolver Resolver, next Reviewer) *CachingReviewer {
+	return &CachingReviewer{
+		resolver: resolver,
+		next:     next,
+		cache:    make(map[string]verdict),
+	}
+}
+
+// Review implements Reviewer.
+func (c *CachingReviewer) Review(image string, annotations map[string]string) (bool, string, error) {
+	digest, err := c.resolver.Resolve(image)
+	if err != nil {
+		return false, "", fmt.Errorf("imagepolicy: failed to resolve digest for %q: %w", image, err)
+	}
+
+	c.mu.RLock()
+	v, ok := c.cache[digest]
+	c.mu.RUnlock()
+	if ok {
+		return v.allowed, v.reason, nil
+	}
+
+	allowed, reason, err := c.next.Review(digest, annotations)
+	if err != nil {
+		return false, "", err
+	}
+
+	c.mu.Lock()
+	c.cache[digest] = verdict{allowed: allowed, reason: reason}
+	c.mu.Unlock()
+
+	return allowed, reason, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy
+
+import (
+	"errors"
+	"testing"
+)
+
+type fakeResolver struct {
+	digests map[string]string
+	err     error
+	calls   int
+}
+
+func (f *fakeResolver) Resolve(image string) (string, error) {
+	f.calls++
+	if f.err != nil {
+		return "", f.err
+	}
+	return f.digests[image], nil
+}
+
+type fakeReviewer struct {
+	allowed bool
+	reason  string
+	err     error
+	calls   int
+	images  []string
+}
+
+func (f *fakeReviewer) Review(image string, annotations map[string]string) (bool, string, error) {
+	f.calls++
+	f.images = append(f.images, image)
+	return f.allowed, f.reason, f.err
+}
+
+func TestCachingReviewer_ResolvesAndCachesByDigest(t *testing.T) {
+	resolver := &fakeResolver{digests: map[string]string{
+		"gcr.io/foo/bar:latest": "gcr.io/foo/bar@sha256:aaa",
+	}}
+	next := &fakeReviewer{allowed: true, reason: "ok"}
+	c := NewCachingReviewer(resolver, next)
+
+	allowed, reason, err := c.Review("gcr.io/foo/bar:latest", nil)
+	if err != nil || !allowed || reason != "ok" {
+		t.Fatalf("Review() = (%v, %q, %v), want (true, %q, nil)", allowed, reason, err, "ok")
+	}
+	if next.calls != 1 || next.images[0] != "gcr.io/foo/bar@sha256:aaa" {
+		t.Fatalf("next.Review called with %v, want one call with the resolved digest", next.images)
+	}
+
+	// Same tag, same resolved digest: served from cache without calling next again.
+	allowed, reason, err = c.Review("gcr.io/foo/bar:latest", nil)
+	if err != nil || !allowed || reason != "ok" {
+		t.Fatalf("cached Review() = (%v, %q, %v), want (true, %q, nil)", allowed, reason, err, "ok")
+	}
+	if next.calls != 1 {
+		t.Errorf("next.Review called %d times, want 1 (second call should hit the cache)", next.calls)
+	}
+	if resolver.calls != 2 {
+		t.Errorf("resolver.Resolve called %d times, want 2: the tag must be re-resolved on every call", resolver.calls)
+	}
+}
+
+func TestCachingReviewer_TagRepushedToNewDigestIsNotStale(t *testing.T) {
+	resolver := &fakeResolver{digests: map[string]string{
+		"gcr.io/foo/bar:latest": "gcr.io/foo/bar@sha256:aaa",
+	}}
+	next := &fakeReviewer{allowed: true, reason: "ok"}
+	c := NewCachingReviewer(resolver, next)
+
+	if _, _, err := c.Review("gcr.io/foo/bar:latest", nil); err != nil {
+		t.Fatalf("Review() error = %v", err)
+	}
+
+	// The tag is repushed to point at a different, disallowed digest.
+	resolver.digests["gcr.io/foo/bar:latest"] = "gcr.io/foo/bar@sha256:bbb"
+	next.allowed = false
+	next.reason = "disallowed"
+
+	allowed, reason, err := c.Review("gcr.io/foo/bar:latest", nil)
+	if err != nil {
+		t.Fatalf("Review() error = %v", err)
+	}
+	if allowed || reason != "disallowed" {
+		t.Errorf("Review() = (%v, %q), want (false, %q): caching by tag would wrongly reuse the old verdict", allowed, reason, "disallowed")
+	}
+	if next.calls != 2 {
+		t.Errorf("next.Review called %d times, want 2: a new digest must not hit the old cache entry", next.calls)
+	}
+}
+
+func TestCachingReviewer_ResolveError(t *testing.T) {
+	resolver := &fakeResolver{err: errors.New("registry unreachable")}
+	next := &fakeReviewer{allowed: true}
+	c := NewCachingReviewer(resolver, next)
+
+	allowed, _, err := c.Review("gcr.io/foo/bar:latest", nil)
+	if err == nil {
+		t.Fatal("Review() error = nil, want non-nil on resolve failure")
+	}
+	if allowed {
+		t.Error("Review() allowed = true on resolve failure, want false")
+	}
+	if next.calls != 0 {
+		t.Errorf("next.Review called %d times, want 0: review must not run when resolution fails", next.calls)
+	}
+}
+
+func TestCachingReviewer_ReviewErrorIsNotCached(t *testing.T) {
+	resolver := &fakeResolver{digests: map[string]string{
+		"gcr.io/foo/bar:latest": "gcr.io/foo/bar@sha256:aaa",
+	}}
+	next := &fakeReviewer{err: errors.New("imagereview backend unavailable")}
+	c := NewCachingReviewer(resolver, next)
+
+	if _, _, err := c.Review("gcr.io/foo/bar:latest", nil); err == nil {
+		t.Fatal("Review() error = nil, want non-nil")
+	}
+	if next.calls != 1 {
+		t.Fatalf("next.Review called %d times, want 1", next.calls)
+	}
+
+	next.err = nil
+	next.allowed = true
+	next.reason = "ok"
+	allowed, reason, err := c.Review("gcr.io/foo/bar:latest", nil)
+	if err != nil || !allowed || reason != "ok" {
+		t.Fatalf("Review() = (%v, %q, %v), want (true, %q, nil)", allowed, reason, err, "ok")
+	}
+	if next.calls != 2 {
+		t.Errorf("next.Review called %d times, want 2: an errored review must not be cached", next.calls)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Reviewer reviews a single container image and reports whether it is
+// allowed.
+type Reviewer interface {
+	Review(image string, annotations map[string]string) (allowed bool, reason string, err error)
+}
+
+// Client reviews images against a configured imagepolicy.k8s.io-style
+// webhook over HTTPS.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient builds a Client that POSTs ImageReview requests to url, trusting
+// caBundle (a PEM-encoded CA certificate) to verify the endpoint's TLS
+// certificate.
+func NewClient(url string, caBundle []byte) (*Client, error) {
+	pool := x509.NewCertPool()
+	if len(caBundle) > 0 && !pool.AppendCertsFromPEM(caBundle) {
+		return nil, fmt.Errorf("imagepolicy: failed to parse CA bundle")
+	}
+
+	return &Client{
+		url: url,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
+			},
+		},
+	}, nil
+}
+
+// Review implements Reviewer.
+func (c *Client) Review(image string, annotations map[string]string) (bool, string, error) {
+	req := &ImageReview{
+		Spec: ImageReviewSpec{
+			Image:       image,
+			Annotations: annotations,
+		},
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return false, "", fmt.Errorf("imagepolicy: failed to marshal ImageReview: %w", err)
+	}
+
+	resp, err := c.httpClient.Post(c.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return false, "", fmt.Errorf("imagepolicy: failed to call %q: %w", c.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, "", fmt.Errorf("imagepolicy: backend returned status %d", resp.StatusCode)
+	}
+
+	var out ImageReview
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return false, "", fmt.Errorf("imagepolicy: failed to decode ImageReview response: %w", err)
+	}
+
+	return out.Status.Allowed, out.Status.Reason, nil
+}
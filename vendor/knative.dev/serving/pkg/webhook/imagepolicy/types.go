@@ -0,0 +1,56 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package imagepolicy implements the client side of an
+// imagepolicy.k8s.io-style ImageReview backend: it builds ImageReview
+// requests for a Revision's container images, posts them to the
+// cluster-configured webhook, and caches the result by resolved image
+// digest so repeated Revisions of the same image skip the round-trip.
+package imagepolicy
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// ImageReview mirrors the imagepolicy.k8s.io ImageReview resource: it
+// carries one container image per request and the backend's verdict on
+// whether it may be admitted.
+type ImageReview struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Spec   ImageReviewSpec   `json:"spec"`
+	Status ImageReviewStatus `json:"status,omitempty"`
+}
+
+// ImageReviewSpec describes the image being reviewed.
+type ImageReviewSpec struct {
+	// Image is the container image being reviewed: the reference as it
+	// appears in PodSpec, or its resolved digest form when the review goes
+	// through CachingReviewer.
+	Image string `json:"image"`
+
+	// Annotations are key/value pairs for policies that key off of them
+	// (e.g. namespace or team annotations). ValidateContainerImages sends
+	// none: it reviews every image with nil annotations.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ImageReviewStatus is the backend's verdict for an ImageReviewSpec.
+type ImageReviewStatus struct {
+	// Allowed indicates whether the image may be admitted.
+	Allowed bool `json:"allowed"`
+
+	// Reason is a human-readable explanation, set when Allowed is false.
+	Reason string `json:"reason,omitempty"`
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/serving/pkg/apis/config"
+	v1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// reviewerKey is the context key under which the webhook's configured
+// Reviewer is stored, mirroring the config-store-in-context pattern used
+// elsewhere in the serving webhook (e.g. metrics, tracing config).
+type reviewerKey struct{}
+
+// ToContext returns a context carrying r, for use by the admission webhook
+// before it invokes Revision validation.
+func ToContext(ctx context.Context, r Reviewer) context.Context {
+	return context.WithValue(ctx, reviewerKey{}, r)
+}
+
+// FromContext extracts the Reviewer stored by ToContext, if any.
+func FromContext(ctx context.Context) (Reviewer, bool) {
+	r, ok := ctx.Value(reviewerKey{}).(Reviewer)
+	return r, ok
+}
+
+// ValidateContainerImages reviews every image in containers and
+// initContainers under policy, returning a field error only when policy is
+// ImagePolicyEnforce and at least one image was rejected. An image from a
+// registry listed in config.ImagePolicy.AllowedRegistries is admitted
+// without consulting the ImageReview backend at all.
+//
+// Under ImagePolicyWarn, rejected images are returned via the allowed bool
+// (false) and reason so that the Revision reconciler can record
+// RevisionConditionImagesAllowed; no FieldError is returned so that
+// admission still succeeds.
+func ValidateContainerImages(ctx context.Context, policy v1.ImagePolicyType, containers, initContainers []corev1.Container) (allowed bool, reason string, errs *apis.FieldError) {
+	if policy == v1.ImagePolicyOff {
+		return true, "", nil
+	}
+
+	reviewer, ok := FromContext(ctx)
+	if !ok {
+		// No backend configured; nothing to enforce or warn about.
+		return true, "", nil
+	}
+	imgPolicy := config.ImagePolicyFromContextOrDefaults(ctx)
+
+	check := func(cs []corev1.Container, field string) {
+		for i, c := range cs {
+			if imgPolicy.IsAllowedRegistry(c.Image) {
+				continue
+			}
+			ok, why, err := reviewer.Review(c.Image, nil)
+			if err != nil {
+				// A reviewer failure is treated like a rejection, not a
+				// structural error: under Warn it must stay non-blocking,
+				// exactly like an explicit "disallowed" verdict.
+				ok, why = false, err.Error()
+			}
+			if !ok {
+				if policy == v1.ImagePolicyEnforce {
+					errs = errs.Also(apis.ErrGeneric(why, "image").ViaFieldIndex(field, i))
+				}
+				allowed, reason = false, why
+			}
+		}
+	}
+
+	allowed = true
+	check(containers, "containers")
+	check(initContainers, "initContainers")
+
+	return allowed, reason, errs
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package revision wires the Revision admission webhook's structural
+// validation (Revision.Validate) together with the ImageReview check from
+// knative.dev/serving/pkg/webhook/imagepolicy.
+package revision
+
+import (
+	"context"
+
+	"knative.dev/serving/pkg/apis/config"
+	v1 "knative.dev/serving/pkg/apis/serving/v1"
+	"knative.dev/serving/pkg/webhook/imagepolicy"
+)
+
+// ValidateRevision runs Revision.Validate and, unless ImagePolicy is Off,
+// reviews the Revision's container images against the webhook's configured
+// ImageReview backend. It returns the FieldError the API server should
+// reject the request with (nil on success), plus whether every image was
+// allowed, for the reconciler to later record via
+// RevisionStatus.MarkImagesAllowed/MarkImagesDisallowed when the policy is
+// Warn rather than Enforce.
+func ValidateRevision(ctx context.Context, r *v1.Revision) (allowed bool, reason string, err error) {
+	if err := r.Validate(ctx); err != nil {
+		return false, "", err
+	}
+
+	policy := r.Spec.ImagePolicy
+	if policy == "" {
+		policy = v1.ImagePolicyType(config.ImagePolicyFromContextOrDefaults(ctx).DefaultPolicy)
+	}
+
+	allowed, reason, fe := imagepolicy.ValidateContainerImages(ctx, policy,
+		r.Spec.Containers, r.Spec.InitContainers)
+	if fe != nil {
+		return allowed, reason, fe
+	}
+	return allowed, reason, nil
+}